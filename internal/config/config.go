@@ -4,38 +4,150 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
 )
 
+// DefaultPricePerMillionTokens is the estimated cost in USD of one million
+// Gemini tokens, used when no price is configured.
+const DefaultPricePerMillionTokens = 0.30
+
+// ProjectConfigFile is the name of the optional project-local config file,
+// read from the current working directory.
+const ProjectConfigFile = "text-game.yaml"
+
+// userConfigDir returns the per-user config directory holding the global
+// config and default saves. It is a variable so tests can override it.
+var userConfigDir = os.UserConfigDir
+
 // Config holds the application configuration.
 type Config struct {
-	GeminiAPIKey string
-	SaveDir      string
+	GeminiAPIKey          string
+	SaveDir               string
+	PricePerMillionTokens float64
+}
+
+// fileConfig mirrors Config for the settings that can be set in a config file.
+// Empty values leave the current setting unchanged.
+type fileConfig struct {
+	GeminiAPIKey          string  `yaml:"gemini_api_key" toml:"gemini_api_key"`
+	SaveDir               string  `yaml:"save_dir" toml:"save_dir"`
+	PricePerMillionTokens float64 `yaml:"price_per_million_tokens" toml:"price_per_million_tokens"`
 }
 
-// LoadConfig loads the configuration from environment variables and defaults.
+// LoadConfig loads the configuration. Settings are applied in order of
+// increasing priority:
+//  1. Defaults
+//  2. Project config (text-game.yaml in the current directory)
+//  3. Global config (text-game/config.toml in the user config directory)
+//  4. Environment variables
 func LoadConfig() (*Config, error) {
-	apiKey := os.Getenv("GEMINI_API_KEY")
-	if apiKey == "" {
-		return nil, fmt.Errorf("GEMINI_API_KEY environment variable is not set.\n\n" +
-			"To play this game, you need a Google Gemini API key.\n" +
-			"1. Get a free key at https://aistudio.google.com/app/apikey\n" +
-			"2. Set it in your terminal: export GEMINI_API_KEY='your-key-here'\n" +
-			"3. Run the game again.")
-	}
-
-	saveDir := os.Getenv("TEXT_GAME_SAVE_DIR")
-	if saveDir == "" {
-		configDir, err := os.UserConfigDir()
-		if err != nil {
-			// Fallback to local directory if we can't find config dir
-			saveDir = ".saves"
-		} else {
-			saveDir = filepath.Join(configDir, "text-game", "saves")
+	cfg := defaultConfig()
+
+	if err := cfg.overlayFile(ProjectConfigFile, yaml.Unmarshal); err != nil {
+		return nil, err
+	}
+
+	if path, err := globalConfigPath(); err == nil {
+		if err := cfg.overlayFile(path, toml.Unmarshal); err != nil {
+			return nil, err
 		}
 	}
 
+	if err := cfg.overlayEnv(); err != nil {
+		return nil, err
+	}
+
+	if cfg.GeminiAPIKey == "" {
+		// Only mention the global config if we know where it lives
+		sources := []string{"Environment variables (GEMINI_API_KEY, TEXT_GAME_SAVE_DIR, TEXT_GAME_PRICE_PER_MILLION_TOKENS)"}
+		if path, err := globalConfigPath(); err == nil {
+			sources = append(sources, fmt.Sprintf("Global config: %s (gemini_api_key = \"...\")", path))
+		}
+		sources = append(sources,
+			fmt.Sprintf("Project config: ./%s (gemini_api_key: \"...\")", ProjectConfigFile),
+			"Defaults",
+		)
+		precedence := ""
+		for i, src := range sources {
+			precedence += fmt.Sprintf("\n  %d. %s", i+1, src)
+		}
+
+		return nil, fmt.Errorf("Gemini API key is not set.\n\n"+
+			"To play this game, you need a Google Gemini API key.\n"+
+			"1. Get a free key at https://aistudio.google.com/app/apikey\n"+
+			"2. Set it in your terminal: export GEMINI_API_KEY='your-key-here'\n"+
+			"3. Run the game again.\n\n"+
+			"Settings are read from the following sources, highest priority first:%s", precedence)
+	}
+
+	return cfg, nil
+}
+
+func defaultConfig() *Config {
+	// Fall back to local directory if we can't find config dir
+	saveDir := ".saves"
+	if configDir, err := userConfigDir(); err == nil {
+		saveDir = filepath.Join(configDir, "text-game", "saves")
+	}
+
 	return &Config{
-		GeminiAPIKey: apiKey,
-		SaveDir:      saveDir,
-	}, nil
+		SaveDir:               saveDir,
+		PricePerMillionTokens: DefaultPricePerMillionTokens,
+	}
+}
+
+func globalConfigPath() (string, error) {
+	configDir, err := userConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, "text-game", "config.toml"), nil
+}
+
+// overlayFile applies the settings from the config file at path, if it exists.
+func (c *Config) overlayFile(path string, unmarshal func([]byte, any) error) error {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var fc fileConfig
+	if err := unmarshal(data, &fc); err != nil {
+		return fmt.Errorf("failed to parse %s: %v", path, err)
+	}
+
+	if fc.GeminiAPIKey != "" {
+		c.GeminiAPIKey = fc.GeminiAPIKey
+	}
+	if fc.SaveDir != "" {
+		c.SaveDir = fc.SaveDir
+	}
+	if fc.PricePerMillionTokens != 0 {
+		c.PricePerMillionTokens = fc.PricePerMillionTokens
+	}
+	return nil
+}
+
+// overlayEnv applies the settings from environment variables.
+func (c *Config) overlayEnv() error {
+	if v := os.Getenv("GEMINI_API_KEY"); v != "" {
+		c.GeminiAPIKey = v
+	}
+	if v := os.Getenv("TEXT_GAME_SAVE_DIR"); v != "" {
+		c.SaveDir = v
+	}
+	if v := os.Getenv("TEXT_GAME_PRICE_PER_MILLION_TOKENS"); v != "" {
+		p, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return fmt.Errorf("invalid TEXT_GAME_PRICE_PER_MILLION_TOKENS %q: %v", v, err)
+		}
+		c.PricePerMillionTokens = p
+	}
+	return nil
 }
@@ -0,0 +1,102 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// setUserConfigDir points the global config and default saves at dir for the
+// duration of the test.
+func setUserConfigDir(t *testing.T, dir string) {
+	t.Helper()
+	orig := userConfigDir
+	userConfigDir = func() (string, error) { return dir, nil }
+	t.Cleanup(func() { userConfigDir = orig })
+}
+
+func TestConfigPrecedence(t *testing.T) {
+	configHome := t.TempDir()
+	projectDir := t.TempDir()
+	setUserConfigDir(t, configHome)
+	t.Setenv("GEMINI_API_KEY", "")
+	t.Setenv("TEXT_GAME_SAVE_DIR", "")
+	t.Setenv("TEXT_GAME_PRICE_PER_MILLION_TOKENS", "")
+	t.Chdir(projectDir)
+
+	writeFile := func(path, content string) {
+		t.Helper()
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Failed to create dir: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", path, err)
+		}
+	}
+
+	// Project config sets everything
+	writeFile(filepath.Join(projectDir, ProjectConfigFile),
+		"gemini_api_key: project-key\nsave_dir: ./saves\nprice_per_million_tokens: 1.5\n")
+	// Global config overrides the key and save dir
+	writeFile(filepath.Join(configHome, "text-game", "config.toml"),
+		"gemini_api_key = \"global-key\"\nsave_dir = \"/global/saves\"\n")
+	// Environment overrides only the key
+	t.Setenv("GEMINI_API_KEY", "env-key")
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+
+	if cfg.GeminiAPIKey != "env-key" {
+		t.Errorf("Expected env key to win, got %s", cfg.GeminiAPIKey)
+	}
+	if cfg.SaveDir != "/global/saves" {
+		t.Errorf("Expected global save dir to win over project, got %s", cfg.SaveDir)
+	}
+	if cfg.PricePerMillionTokens != 1.5 {
+		t.Errorf("Expected project price to win over default, got %v", cfg.PricePerMillionTokens)
+	}
+}
+
+func TestConfigDefaults(t *testing.T) {
+	configHome := t.TempDir()
+	setUserConfigDir(t, configHome)
+	t.Setenv("GEMINI_API_KEY", "env-key")
+	t.Setenv("TEXT_GAME_SAVE_DIR", "")
+	t.Setenv("TEXT_GAME_PRICE_PER_MILLION_TOKENS", "")
+	t.Chdir(t.TempDir())
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+
+	if want := filepath.Join(configHome, "text-game", "saves"); cfg.SaveDir != want {
+		t.Errorf("Expected default save dir %s, got %s", want, cfg.SaveDir)
+	}
+	if cfg.PricePerMillionTokens != DefaultPricePerMillionTokens {
+		t.Errorf("Expected default price %v, got %v", DefaultPricePerMillionTokens, cfg.PricePerMillionTokens)
+	}
+}
+
+func TestConfigMissingKeyWithoutConfigDir(t *testing.T) {
+	orig := userConfigDir
+	userConfigDir = func() (string, error) { return "", errors.New("no config dir") }
+	t.Cleanup(func() { userConfigDir = orig })
+	t.Setenv("GEMINI_API_KEY", "")
+	t.Chdir(t.TempDir())
+
+	_, err := LoadConfig()
+	if err == nil {
+		t.Fatal("Expected error for missing API key")
+	}
+	if strings.Contains(err.Error(), "Global config") {
+		t.Errorf("Expected no global config line when its path is unknown, got:\n%v", err)
+	}
+	if !strings.Contains(err.Error(), "2. Project config: ./"+ProjectConfigFile) {
+		t.Errorf("Expected project config to be listed second, got:\n%v", err)
+	}
+}
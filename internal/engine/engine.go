@@ -23,9 +23,18 @@ var processTurnPrompt string
 //go:embed prompts/summarize_history.txt
 var summarizeHistoryPrompt string
 
+//go:embed prompts/classify_ambiguity.txt
+var classifyAmbiguityPrompt string
+
+// StatusClarify is the turn status returned when the player's action was too
+// ambiguous to act on. The game state is left unchanged.
+const StatusClarify = "CLARIFY"
+
 type Engine struct {
 	client *genai.Client
 	model  *genai.GenerativeModel
+
+	lastCallTokens int // tokens used by the most recent GenerateWorld or ProcessTurn call
 }
 
 func NewEngine(ctx context.Context, apiKey string) (*Engine, error) {
@@ -46,7 +55,24 @@ func (e *Engine) Close() {
 	e.client.Close()
 }
 
+// LastCallTokens returns the total number of tokens used by the most recent
+// GenerateWorld or ProcessTurn call, including any history summarization or
+// ambiguity check it triggered. Tokens spent before a call failed are
+// included.
+func (e *Engine) LastCallTokens() int {
+	return e.lastCallTokens
+}
+
+func tokenCount(resp *genai.GenerateContentResponse) int {
+	if resp == nil || resp.UsageMetadata == nil {
+		return 0
+	}
+	return int(resp.UsageMetadata.TotalTokenCount)
+}
+
 func (e *Engine) GenerateWorld(ctx context.Context, hint string) (*models.GameSession, error) {
+	e.lastCallTokens = 0
+
 	tmpl, err := template.New("generate_world").Parse(generateWorldPrompt)
 	if err != nil {
 		return nil, err
@@ -61,6 +87,7 @@ func (e *Engine) GenerateWorld(ctx context.Context, hint string) (*models.GameSe
 	if err != nil {
 		return nil, err
 	}
+	e.lastCallTokens += tokenCount(resp)
 
 	if len(resp.Candidates) == 0 || len(resp.Candidates[0].Content.Parts) == 0 {
 		return nil, fmt.Errorf("no content returned from Gemini")
@@ -100,6 +127,23 @@ func (e *Engine) GenerateWorld(ctx context.Context, hint string) (*models.GameSe
 }
 
 func (e *Engine) ProcessTurn(ctx context.Context, session *models.GameSession, action string) (string, string, string, error) {
+	e.lastCallTokens = 0
+
+	// Refine a previously ambiguous action with the player's clarification,
+	// or check whether this action needs one.
+	if refined, ok := refineAction(session, action); ok {
+		action = refined
+	} else if session.World.AmbiguityHandling == models.AmbiguityAsk {
+		ambiguous, err := e.isAmbiguous(ctx, session, action)
+		if err != nil {
+			// Fall back to letting the GM interpret the action
+			fmt.Printf("Warning: failed to classify action: %v\n", err)
+		} else if ambiguous {
+			session.History.PendingAction = action
+			return fmt.Sprintf("It's not clear what you mean by \"%s\". Could you be more specific?", action), StatusClarify, "", nil
+		}
+	}
+
 	// If history is too long, summarize it
 	if len(session.History.Entries) > 8 {
 		if err := e.SummarizeHistory(ctx, session); err != nil {
@@ -124,7 +168,7 @@ func (e *Engine) ProcessTurn(ctx context.Context, session *models.GameSession, a
 
 	knownLocations := ""
 	for name, loc := range session.Locations {
-		knownLocations += fmt.Sprintf("- %s: %s (People: %v, Objects: %v)\n", name, loc.Description, loc.People, loc.Objects)
+		knownLocations += fmt.Sprintf("- %s: %s (People: %v, Objects: %v, Exits: %v)\n", name, loc.Description, loc.People, loc.Objects, loc.Exits)
 	}
 
 	tmpl, err := template.New("process_turn").Parse(processTurnPrompt)
@@ -145,6 +189,8 @@ func (e *Engine) ProcessTurn(ctx context.Context, session *models.GameSession, a
 		Progress         string
 		History          string
 		Action           string
+		SampleDialogue   string
+		Achievements     string
 	}{
 		WorldDescription: session.World.Description,
 		WinConditions:    session.World.WinConditions,
@@ -157,6 +203,8 @@ func (e *Engine) ProcessTurn(ctx context.Context, session *models.GameSession, a
 		Progress:         session.State.Progress,
 		History:          historyText,
 		Action:           action,
+		SampleDialogue:   strings.TrimSpace(session.World.SampleDialogue),
+		Achievements:     strings.Join(achievementNames(session.History.Achievements), ", "),
 	}
 
 	if err := tmpl.Execute(&buf, data); err != nil {
@@ -167,6 +215,7 @@ func (e *Engine) ProcessTurn(ctx context.Context, session *models.GameSession, a
 	if err != nil {
 		return "", "", "", err
 	}
+	e.lastCallTokens += tokenCount(resp)
 
 	if len(resp.Candidates) == 0 || len(resp.Candidates[0].Content.Parts) == 0 {
 		return "", "", "", fmt.Errorf("no content returned from Gemini")
@@ -183,41 +232,265 @@ func (e *Engine) ProcessTurn(ctx context.Context, session *models.GameSession, a
 	cleanYAML = strings.TrimPrefix(cleanYAML, "```")
 	cleanYAML = strings.TrimSuffix(cleanYAML, "```")
 
-	type TurnResult struct {
-		Outcome            string            `yaml:"outcome"`
-		Status             string            `yaml:"status"`
-		DiscoveredLocation *models.Location  `yaml:"discovered_location"`
-		Explanations       []string          `yaml:"explanations"`
-		Changes            map[string]string `yaml:"changes"`
-		State              models.GameState  `yaml:"state"`
-	}
-
-	var result TurnResult
+	var result turnResult
 	err = yaml.Unmarshal([]byte(cleanYAML), &result)
 	if err != nil {
 		return "", "", "", fmt.Errorf("failed to parse turn YAML: %v\nOutput was: %s", err, cleanYAML)
 	}
 
-	// Update session
+	discoveredName := applyTurnResult(session, action, result)
+	status := session.History.Entries[len(session.History.Entries)-1].Status
+	return result.Outcome, status, discoveredName, nil
+}
+
+// refineAction combines an action awaiting clarification with the player's
+// clarification and clears it. It reports false if nothing was pending.
+func refineAction(session *models.GameSession, clarification string) (string, bool) {
+	pending := session.History.PendingAction
+	if pending == "" {
+		return clarification, false
+	}
+	session.History.PendingAction = ""
+	return fmt.Sprintf("%s (clarified: %s)", pending, clarification), true
+}
+
+// isAmbiguous asks the model whether the action is too unclear to act on.
+func (e *Engine) isAmbiguous(ctx context.Context, session *models.GameSession, action string) (bool, error) {
+	tmpl, err := template.New("classify_ambiguity").Parse(classifyAmbiguityPrompt)
+	if err != nil {
+		return false, err
+	}
+
+	var buf bytes.Buffer
+	data := struct {
+		CurrentLocation string
+		Action          string
+	}{
+		CurrentLocation: session.State.CurrentLocation,
+		Action:          action,
+	}
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return false, err
+	}
+
+	resp, err := e.model.GenerateContent(ctx, genai.Text(buf.String()))
+	if err != nil {
+		return false, err
+	}
+	e.lastCallTokens += tokenCount(resp)
+
+	if len(resp.Candidates) == 0 || len(resp.Candidates[0].Content.Parts) == 0 {
+		return false, fmt.Errorf("no content returned from Gemini during classification")
+	}
+
+	part := resp.Candidates[0].Content.Parts[0]
+	text, ok := part.(genai.Text)
+	if !ok {
+		return false, fmt.Errorf("unexpected response type from Gemini during classification")
+	}
+
+	return strings.HasPrefix(strings.ToUpper(strings.TrimSpace(string(text))), "YES"), nil
+}
+
+// turnResult is the parsed response to the process_turn prompt.
+type turnResult struct {
+	Outcome            string               `yaml:"outcome"`
+	Status             string               `yaml:"status"`
+	DiscoveredLocation *models.Location     `yaml:"discovered_location"`
+	Explanations       []string             `yaml:"explanations"`
+	Changes            map[string]string    `yaml:"changes"`
+	Achievements       []models.Achievement `yaml:"achievements"`
+	State              models.GameState     `yaml:"state"`
+}
+
+// normalizeStatus upper-cases a turn status and treats a missing one as
+// "PLAYING", so a turn only ends the game when it says "WON" or "LOST".
+func normalizeStatus(status string) string {
+	status = strings.ToUpper(strings.TrimSpace(status))
+	if status == "" {
+		return "PLAYING"
+	}
+	return status
+}
+
+// canonicalLocation returns the key of the known location matching name,
+// ignoring case and surrounding space, or the trimmed name if none matches.
+// Saves lower-case location file names, so two keys differing only in case
+// would overwrite each other.
+func canonicalLocation(session *models.GameSession, name string) string {
+	name = strings.TrimSpace(name)
+	if _, ok := session.Locations[name]; ok {
+		return name
+	}
+	for key := range session.Locations {
+		if strings.EqualFold(strings.TrimSpace(key), name) {
+			return key
+		}
+	}
+	return name
+}
+
+// applyTurnResult updates the session with the outcome of a turn and returns
+// the name of the newly discovered location, if any.
+func applyTurnResult(session *models.GameSession, action string, result turnResult) string {
+	previousLocation := session.State.CurrentLocation
+
 	session.State = result.State
+	session.State.Inventory = deduplicateInventory(session.State.Inventory)
+	if session.Locations == nil {
+		session.Locations = make(map[string]models.Location)
+	}
+
 	discoveredName := ""
-	if result.DiscoveredLocation != nil && result.DiscoveredLocation.Name != "" {
-		discoveredName = result.DiscoveredLocation.Name
-		if session.Locations == nil {
-			session.Locations = make(map[string]models.Location)
+	if result.DiscoveredLocation != nil && strings.TrimSpace(result.DiscoveredLocation.Name) != "" {
+		// Rediscovering a known place updates it under its existing name
+		loc := *result.DiscoveredLocation
+		loc.Name = canonicalLocation(session, loc.Name)
+		discoveredName = loc.Name
+		session.Locations[loc.Name] = loc
+		linkExits(session, loc.Name)
+	}
+
+	// The new location must be known, newly discovered, or unchanged.
+	// Anything else was made up by the model, so we stay put and record
+	// the place so it can be referred to consistently from now on. The
+	// description is a deliberate placeholder: generating one would cost an
+	// extra API call, and the GM fleshes the place out if the player goes there.
+	newLocation := canonicalLocation(session, session.State.CurrentLocation)
+	if newLocation == "" || strings.EqualFold(newLocation, strings.TrimSpace(previousLocation)) {
+		session.State.CurrentLocation = previousLocation
+	} else if _, known := session.Locations[newLocation]; known {
+		session.State.CurrentLocation = newLocation
+	} else {
+		fmt.Printf("Warning: unknown location %q in turn result, staying at %q\n", newLocation, previousLocation)
+		session.State.CurrentLocation = previousLocation
+		session.Locations[newLocation] = models.Location{
+			Name:        newLocation,
+			Description: fmt.Sprintf("A place mentioned while at %s, not yet explored.", previousLocation),
+		}
+		if discoveredName == "" {
+			discoveredName = newLocation
 		}
-		session.Locations[result.DiscoveredLocation.Name] = *result.DiscoveredLocation
 	}
+
+	unlocked := newAchievements(session.History.Achievements, result.Achievements)
+	session.History.Achievements = append(session.History.Achievements, unlocked...)
 	session.History.Entries = append(session.History.Entries, models.HistoryEntry{
 		PlayerAction: action,
 		Outcome:      result.Outcome,
-		Status:       result.Status,
+		Status:       normalizeStatus(result.Status),
 		Explanations: result.Explanations,
 		Changes:      result.Changes,
-		Inventory:    result.State.Inventory,
+		Inventory:    session.State.Inventory,
+		Achievements: unlocked,
 	})
 
-	return result.Outcome, result.Status, discoveredName, nil
+	return discoveredName
+}
+
+var oppositeDirections = map[string]string{
+	"north":     "south",
+	"south":     "north",
+	"east":      "west",
+	"west":      "east",
+	"northeast": "southwest",
+	"southwest": "northeast",
+	"northwest": "southeast",
+	"southeast": "northwest",
+	"up":        "down",
+	"down":      "up",
+}
+
+// linkExits adds the missing reverse exits between the named location and
+// every known location it connects to, in either direction, so the location
+// graph stays navigable both ways.
+func linkExits(session *models.GameSession, name string) {
+	// Exits leading out of the new location get a way back
+	loc := session.Locations[name]
+	for dir, target := range loc.Exits {
+		if target == name {
+			continue
+		}
+		if _, ok := session.Locations[target]; ok {
+			addExit(session, target, oppositeDirections[strings.ToLower(dir)], name)
+		}
+	}
+
+	// Exits from known locations leading into the new one get a way out
+	for other, otherLoc := range session.Locations {
+		if other == name {
+			continue
+		}
+		for dir, target := range otherLoc.Exits {
+			if target == name {
+				addExit(session, name, oppositeDirections[strings.ToLower(dir)], other)
+			}
+		}
+	}
+}
+
+// addExit adds an exit from one location to another unless the direction is
+// unknown or already taken (in any letter case).
+func addExit(session *models.GameSession, from, dir, to string) {
+	if dir == "" {
+		return
+	}
+	loc := session.Locations[from]
+	for existing := range loc.Exits {
+		if strings.EqualFold(existing, dir) {
+			return
+		}
+	}
+	if loc.Exits == nil {
+		loc.Exits = make(map[string]string)
+	}
+	loc.Exits[dir] = to
+	session.Locations[from] = loc
+}
+
+func achievementNames(achievements []models.Achievement) []string {
+	var names []string
+	for _, a := range achievements {
+		names = append(names, a.Name)
+	}
+	return names
+}
+
+// newAchievements returns the candidates that have not already been earned,
+// ignoring unnamed entries and repeats within the candidates themselves.
+func newAchievements(earned, candidates []models.Achievement) []models.Achievement {
+	seen := make(map[string]bool)
+	for _, a := range earned {
+		seen[strings.ToLower(a.Name)] = true
+	}
+	var result []models.Achievement
+	for _, a := range candidates {
+		key := strings.ToLower(strings.TrimSpace(a.Name))
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, a)
+	}
+	return result
+}
+
+// deduplicateInventory removes repeated items from the inventory while
+// preserving the order in which they first appear.
+func deduplicateInventory(items []string) []string {
+	if items == nil {
+		return nil
+	}
+	seen := make(map[string]bool)
+	result := make([]string, 0, len(items))
+	for _, item := range items {
+		if seen[item] {
+			continue
+		}
+		seen[item] = true
+		result = append(result, item)
+	}
+	return result
 }
 
 func (e *Engine) SummarizeHistory(ctx context.Context, session *models.GameSession) error {
@@ -257,6 +530,7 @@ func (e *Engine) SummarizeHistory(ctx context.Context, session *models.GameSessi
 	if err != nil {
 		return err
 	}
+	e.lastCallTokens += tokenCount(resp)
 
 	if len(resp.Candidates) == 0 || len(resp.Candidates[0].Content.Parts) == 0 {
 		return fmt.Errorf("no content returned from Gemini during summarization")
@@ -269,6 +543,8 @@ func (e *Engine) SummarizeHistory(ctx context.Context, session *models.GameSessi
 	}
 
 	session.History.Summary = strings.TrimSpace(string(text))
+	session.History.SummaryLog = append(session.History.SummaryLog, session.History.Summary)
+	session.History.SummarizedTurns += len(toSummarize)
 	session.History.Entries = remaining
 	return nil
 }
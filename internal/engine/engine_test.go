@@ -0,0 +1,202 @@
+package engine
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/tatianab/text-game/internal/models"
+)
+
+func TestDeduplicateInventory(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []string
+		want  []string
+	}{
+		{
+			name:  "empty",
+			input: []string{},
+			want:  []string{},
+		},
+		{
+			name:  "all unique",
+			input: []string{"torch", "iron key", "map"},
+			want:  []string{"torch", "iron key", "map"},
+		},
+		{
+			name:  "fully duplicate",
+			input: []string{"torch", "torch", "torch"},
+			want:  []string{"torch"},
+		},
+		{
+			name:  "preserves order",
+			input: []string{"torch", "iron key", "torch", "map", "iron key"},
+			want:  []string{"torch", "iron key", "map"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := deduplicateInventory(tt.input)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestNewAchievements(t *testing.T) {
+	earned := []models.Achievement{{Name: "First Blood"}}
+
+	tests := []struct {
+		name       string
+		candidates []models.Achievement
+		want       []string
+	}{
+		{"none", nil, nil},
+		{"new", []models.Achievement{{Name: "Lost Explorer"}}, []string{"Lost Explorer"}},
+		{"already earned", []models.Achievement{{Name: "First Blood"}}, nil},
+		{"case-insensitive", []models.Achievement{{Name: "first blood"}}, nil},
+		{"unnamed", []models.Achievement{{Name: "  ", Description: "Mystery"}}, nil},
+		{
+			"repeated in batch",
+			[]models.Achievement{{Name: "Lost Explorer"}, {Name: "LOST EXPLORER"}, {Name: "Night Owl"}},
+			[]string{"Lost Explorer", "Night Owl"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := achievementNames(newAchievements(earned, tt.candidates))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLocationConsistency(t *testing.T) {
+	newSession := func() *models.GameSession {
+		return &models.GameSession{
+			State: models.GameState{CurrentLocation: "Entrance"},
+			Locations: map[string]models.Location{
+				"Entrance": {Name: "Entrance"},
+				"Old Mill": {Name: "Old Mill"},
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		location      string
+		discovered    *models.Location
+		wantLocation  string
+		wantLocations []string
+	}{
+		{"known location", "Old Mill", nil, "Old Mill", []string{"Entrance", "Old Mill"}},
+		{"known location in other case", " old mill ", nil, "Old Mill", []string{"Entrance", "Old Mill"}},
+		{"no movement", "entrance", nil, "Entrance", []string{"Entrance", "Old Mill"}},
+		{"discovered location", "Cellar", &models.Location{Name: "Cellar"}, "Cellar", []string{"Cellar", "Entrance", "Old Mill"}},
+		{"rediscovered location", "OLD MILL", &models.Location{Name: "old mill"}, "Old Mill", []string{"Entrance", "Old Mill"}},
+		{"hallucinated location", "Throne Room", nil, "Entrance", []string{"Entrance", "Old Mill", "Throne Room"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session := newSession()
+			applyTurnResult(session, "walk", turnResult{
+				Status:             "PLAYING",
+				DiscoveredLocation: tt.discovered,
+				State:              models.GameState{CurrentLocation: tt.location},
+			})
+			if session.State.CurrentLocation != tt.wantLocation {
+				t.Errorf("Expected location %s, got %s", tt.wantLocation, session.State.CurrentLocation)
+			}
+			var got []string
+			for name := range session.Locations {
+				got = append(got, name)
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.wantLocations) {
+				t.Errorf("Expected locations %v, got %v", tt.wantLocations, got)
+			}
+		})
+	}
+}
+
+func TestLinkExits(t *testing.T) {
+	session := &models.GameSession{
+		Locations: map[string]models.Location{
+			// Points at the new location, which lists no exit back
+			"Hall": {Name: "Hall", Exits: map[string]string{"east": "Cellar"}},
+			// Reached from the new location
+			"Garden": {Name: "Garden"},
+			// Direction back is already taken, so it is left alone
+			"Kitchen": {Name: "Kitchen", Exits: map[string]string{"down": "Larder"}},
+			"Cellar": {Name: "Cellar", Exits: map[string]string{
+				"North": "Garden",
+				"up":    "Kitchen",
+				"south": "Well", // not yet discovered
+			}},
+		},
+	}
+
+	linkExits(session, "Cellar")
+
+	want := map[string]map[string]string{
+		"Hall":    {"east": "Cellar"},
+		"Garden":  {"south": "Cellar"},
+		"Kitchen": {"down": "Larder"},
+		"Cellar":  {"North": "Garden", "up": "Kitchen", "south": "Well", "west": "Hall"},
+	}
+	for name, exits := range want {
+		if got := session.Locations[name].Exits; !reflect.DeepEqual(got, exits) {
+			t.Errorf("%s: expected exits %v, got %v", name, exits, got)
+		}
+	}
+	if _, ok := session.Locations["Well"]; ok {
+		t.Errorf("Expected undiscovered location Well not to be added")
+	}
+}
+
+func TestApplyTurnResultStatus(t *testing.T) {
+	tests := []struct {
+		status     string
+		want       string
+		wantActive bool
+	}{
+		{"", "PLAYING", true},
+		{"playing", "PLAYING", true},
+		{"WON", "WON", false},
+		{" lost ", "LOST", false},
+	}
+
+	for _, tt := range tests {
+		session := &models.GameSession{}
+		applyTurnResult(session, "look", turnResult{Status: tt.status})
+		last := session.History.Entries[len(session.History.Entries)-1]
+		if last.Status != tt.want {
+			t.Errorf("Status %q: expected %q, got %q", tt.status, tt.want, last.Status)
+		}
+		if session.IsActive() != tt.wantActive {
+			t.Errorf("Status %q: expected IsActive() = %v", tt.status, tt.wantActive)
+		}
+	}
+}
+
+func TestRefineAction(t *testing.T) {
+	session := &models.GameSession{}
+	if got, ok := refineAction(session, "look"); ok || got != "look" {
+		t.Errorf("Expected unrefined action with nothing pending, got %q (refined: %v)", got, ok)
+	}
+
+	session.History.PendingAction = "I go there"
+	got, ok := refineAction(session, "the barn")
+	if !ok || got != "I go there (clarified: the barn)" {
+		t.Errorf("Expected refined action, got %q (refined: %v)", got, ok)
+	}
+	if session.History.PendingAction != "" {
+		t.Errorf("Expected pending action to be cleared, got %q", session.History.PendingAction)
+	}
+}
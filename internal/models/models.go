@@ -2,17 +2,25 @@ package models
 
 // World represents the static (or semi-static) world definition.
 type World struct {
-	Title            string            `yaml:"title"`
-	ShortName        string            `yaml:"short_name"` // e.g., "hidden-manor"
-	Description      string            `yaml:"description"`
-	Possibilities    []string          `yaml:"possibilities"` // e.g., what sorts of actions a player can take
-	StateSchema      string            `yaml:"state_schema"`  // description of what sort of state will be held
-	StatDisplayNames map[string]string `yaml:"stat_display_names"` // machine_name -> "Human Readable Name"
-	StatPolarities   map[string]string `yaml:"stat_polarities"`    // machine_name -> "good" or "bad"
-	WinConditions    string            `yaml:"win_conditions"`
-	LoseConditions   string            `yaml:"lose_conditions"`
+	Title             string            `yaml:"title"`
+	ShortName         string            `yaml:"short_name"` // e.g., "hidden-manor"
+	Description       string            `yaml:"description"`
+	Possibilities     []string          `yaml:"possibilities"` // e.g., what sorts of actions a player can take
+	StateSchema       string            `yaml:"state_schema"`  // description of what sort of state will be held
+	StatDisplayNames  map[string]string `yaml:"stat_display_names"` // machine_name -> "Human Readable Name"
+	StatPolarities    map[string]string `yaml:"stat_polarities"`    // machine_name -> "good" or "bad"
+	WinConditions     string            `yaml:"win_conditions"`
+	LoseConditions    string            `yaml:"lose_conditions"`
+	SampleDialogue    string            `yaml:"sample_dialogue"`              // short sample conversation that sets the writing style
+	AmbiguityHandling string            `yaml:"ambiguity_handling,omitempty"` // AmbiguityInterpret (default) or AmbiguityAsk
 }
 
+// Values for World.AmbiguityHandling.
+const (
+	AmbiguityInterpret = "interpret" // the GM interprets unclear actions as best it can
+	AmbiguityAsk       = "ask"       // the GM asks the player to clarify unclear actions
+)
+
 // GameState represents the current dynamic state of the game.
 type GameState struct {
 	Inventory       []string          `yaml:"inventory"`
@@ -30,12 +38,23 @@ type HistoryEntry struct {
 	Explanations []string          `yaml:"explanations,omitempty"`
 	Changes      map[string]string `yaml:"changes,omitempty"`   // e.g., {"health": "-10"}
 	Inventory    []string          `yaml:"inventory,omitempty"` // current inventory after the turn
+	Achievements []Achievement     `yaml:"achievements,omitempty"` // achievements unlocked this turn
+}
+
+// Achievement represents a notable accomplishment earned by the player.
+type Achievement struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
 }
 
 // GameHistory contains the abbreviated history of the game.
 type GameHistory struct {
-	Summary string         `yaml:"summary"`
-	Entries []HistoryEntry `yaml:"entries"`
+	Summary         string         `yaml:"summary"`                    // most recent summary
+	SummaryLog      []string       `yaml:"summary_log,omitempty"`      // every revision of the summary, oldest first
+	SummarizedTurns int            `yaml:"summarized_turns,omitempty"` // number of turns folded into Summary
+	Entries         []HistoryEntry `yaml:"entries"`
+	Achievements    []Achievement  `yaml:"achievements,omitempty"`   // all achievements earned so far
+	PendingAction   string         `yaml:"pending_action,omitempty"` // ambiguous action awaiting clarification
 }
 
 // Location represents a specific place in the world.
@@ -44,6 +63,8 @@ type Location struct {
 	Description string   `yaml:"description"`
 	People      []string `yaml:"people"`
 	Objects     []string `yaml:"objects"`
+	// Exits maps a direction (e.g., "north") to the name of the connected location.
+	Exits map[string]string `yaml:"exits,omitempty"`
 }
 
 // GameSession aggregates all game-related data.
@@ -53,3 +74,24 @@ type GameSession struct {
 	History   GameHistory         `yaml:"history"`
 	Locations map[string]Location `yaml:"locations"` // Keyed by location name
 }
+
+// TurnCount returns the number of turns played, including those that have
+// been folded into the history summary.
+func (s *GameSession) TurnCount() int {
+	return s.History.SummarizedTurns + len(s.History.Entries)
+}
+
+// AgeInTurns returns how many turns old the session is. Like TurnCount, it
+// includes summarized turns, so it does not shrink when history is condensed.
+func (s *GameSession) AgeInTurns() int {
+	return s.TurnCount()
+}
+
+// IsActive reports whether the game is still in progress, i.e. the last turn
+// has status "PLAYING". A session with no history is active.
+func (s *GameSession) IsActive() bool {
+	if len(s.History.Entries) == 0 {
+		return true
+	}
+	return s.History.Entries[len(s.History.Entries)-1].Status == "PLAYING"
+}
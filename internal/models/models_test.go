@@ -50,3 +50,37 @@ func TestGameSessionYAML(t *testing.T) {
 		t.Errorf("Expected 1 history entry, got %d", len(session2.History.Entries))
 	}
 }
+
+func TestIsActive(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []HistoryEntry
+		want    bool
+	}{
+		{"empty history", nil, true},
+		{"playing", []HistoryEntry{{Status: "WON"}, {Status: "PLAYING"}}, true},
+		{"won", []HistoryEntry{{Status: "PLAYING"}, {Status: "WON"}}, false},
+		{"missing status", []HistoryEntry{{Status: ""}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session := &GameSession{History: GameHistory{Entries: tt.entries}}
+			if got := session.IsActive(); got != tt.want {
+				t.Errorf("Expected IsActive() = %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestAgeInTurns(t *testing.T) {
+	session := &GameSession{
+		History: GameHistory{
+			SummarizedTurns: 6,
+			Entries:         []HistoryEntry{{}, {}, {}},
+		},
+	}
+	if got := session.AgeInTurns(); got != 9 {
+		t.Errorf("Expected 9 turns, got %d", got)
+	}
+}
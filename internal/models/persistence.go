@@ -60,9 +60,12 @@ func (s *GameSession) Save(name string) error {
 		return err
 	}
 
-	// Save locations
+	// Save locations, replacing any left over from an earlier save to the same name
+	locDir := filepath.Join(dir, "locations")
+	if err := os.RemoveAll(locDir); err != nil {
+		return err
+	}
 	if len(s.Locations) > 0 {
-		locDir := filepath.Join(dir, "locations")
 		if err := os.MkdirAll(locDir, 0755); err != nil {
 			return err
 		}
@@ -88,7 +91,7 @@ func LoadSession(name string) (*GameSession, error) {
 	// Check version
 	vData, err := os.ReadFile(filepath.Join(dir, "version.yaml"))
 	if err != nil {
-		return nil, fmt.Errorf("could not read version info (save may be too old): %v", err)
+		return nil, fmt.Errorf("could not read version info (save may be too old): %w", err)
 	}
 	var vInfo versionInfo
 	if err := yaml.Unmarshal(vData, &vInfo); err != nil {
@@ -178,3 +181,18 @@ func ListSessions() ([]string, error) {
 	}
 	return sessions, nil
 }
+
+// IsSessionActive reports whether the named save is a game still in progress.
+// Only the history is read, so this is cheap enough for listing saves.
+func IsSessionActive(name string) (bool, error) {
+	historyData, err := os.ReadFile(filepath.Join(SaveDir, name, "history.yaml"))
+	if err != nil {
+		return false, err
+	}
+	var history GameHistory
+	if err := yaml.Unmarshal(historyData, &history); err != nil {
+		return false, err
+	}
+	session := GameSession{History: history}
+	return session.IsActive(), nil
+}
@@ -0,0 +1,88 @@
+package models
+
+import "testing"
+
+// useTempSaveDir points SaveDir at a fresh directory for the duration of the test.
+func useTempSaveDir(t *testing.T) {
+	t.Helper()
+	orig := SaveDir
+	SaveDir = t.TempDir()
+	t.Cleanup(func() { SaveDir = orig })
+}
+
+func TestIsSessionActive(t *testing.T) {
+	useTempSaveDir(t)
+
+	playing := &GameSession{History: GameHistory{Entries: []HistoryEntry{{Status: "PLAYING"}}}}
+	won := &GameSession{History: GameHistory{Entries: []HistoryEntry{{Status: "WON"}}}}
+	if err := playing.Save("playing"); err != nil {
+		t.Fatalf("Failed to save session: %v", err)
+	}
+	if err := won.Save("won"); err != nil {
+		t.Fatalf("Failed to save session: %v", err)
+	}
+
+	if active, err := IsSessionActive("playing"); err != nil || !active {
+		t.Errorf("Expected playing session to be active, got %v (err: %v)", active, err)
+	}
+	if active, err := IsSessionActive("won"); err != nil || active {
+		t.Errorf("Expected won session to be inactive, got %v (err: %v)", active, err)
+	}
+	if _, err := IsSessionActive("missing"); err == nil {
+		t.Errorf("Expected error for missing session")
+	}
+}
+
+func TestSaveReplacesPreviousSession(t *testing.T) {
+	useTempSaveDir(t)
+
+	first := &GameSession{
+		World: World{Title: "Game A"},
+		Locations: map[string]Location{
+			"Crypt": {Name: "Crypt"},
+			"Altar": {Name: "Altar"},
+		},
+	}
+	second := &GameSession{
+		World: World{Title: "Game B"},
+		Locations: map[string]Location{
+			"Dock": {Name: "Dock"},
+		},
+	}
+	if err := first.Save("quicksave-1"); err != nil {
+		t.Fatalf("Failed to save first session: %v", err)
+	}
+	if err := second.Save("quicksave-1"); err != nil {
+		t.Fatalf("Failed to save second session: %v", err)
+	}
+
+	loaded, err := LoadSession("quicksave-1")
+	if err != nil {
+		t.Fatalf("Failed to load session: %v", err)
+	}
+	if loaded.World.Title != "Game B" {
+		t.Errorf("Expected title Game B, got %s", loaded.World.Title)
+	}
+	if len(loaded.Locations) != 1 {
+		t.Errorf("Expected 1 location, got %d: %v", len(loaded.Locations), loaded.Locations)
+	}
+	if _, ok := loaded.Locations["Dock"]; !ok {
+		t.Errorf("Expected location Dock to be loaded")
+	}
+}
+
+func TestSavePendingAction(t *testing.T) {
+	useTempSaveDir(t)
+
+	session := &GameSession{History: GameHistory{PendingAction: "I go there"}}
+	if err := session.Save("pending"); err != nil {
+		t.Fatalf("Failed to save session: %v", err)
+	}
+	loaded, err := LoadSession("pending")
+	if err != nil {
+		t.Fatalf("Failed to load session: %v", err)
+	}
+	if loaded.History.PendingAction != "I go there" {
+		t.Errorf("Expected pending action to survive a save, got %q", loaded.History.PendingAction)
+	}
+}
@@ -2,9 +2,12 @@ package tui
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/textarea"
@@ -50,6 +53,22 @@ type model struct {
 	lastSearch  string
 	loadingTurn bool
 	isFinished  bool
+
+	// Saves listed on the hint screen, built once on entering it
+	savesList string
+
+	// Temporary confirmation shown above the help line
+	statusMsg string
+	statusID  int
+
+	// Number of history entries reconstructed by /load, so renderLog can
+	// separate them from turns played in this session.
+	loadedHistoryLen int
+
+	// Achievement overlay
+	achievements     []models.Achievement
+	achievementTicks int
+	achievementID    int
 }
 
 var (
@@ -104,8 +123,62 @@ var (
 	discoveryStyle = lipgloss.NewStyle().
 			Foreground(lipgloss.Color("#D7D7AF")). // Pale Yellow/Beige
 			Bold(true)
+
+	faintStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#5F5F5F")) // Dark Gray
+
+	achievementStyle = lipgloss.NewStyle().
+				Border(lipgloss.RoundedBorder()).
+				BorderForeground(lipgloss.Color("#FFD700")). // Gold
+				Padding(0, 2)
+
+	achievementNameStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("#FFD700")).
+				Bold(true)
+)
+
+// quickSavePrefix names the fixed save slots used by the quick-save hotkeys.
+const quickSavePrefix = "quicksave-"
+
+// quickSaveKeys maps F1-F5 to quick-save slots.
+var quickSaveKeys = map[tea.KeyType]int{
+	tea.KeyF1: 1, tea.KeyF2: 2, tea.KeyF3: 3, tea.KeyF4: 4, tea.KeyF5: 5,
+}
+
+// quickLoadKeys maps Shift+F1-F5 to quick-save slots. Terminals that support
+// it report Shift+F1-F5 as F13-F17.
+var quickLoadKeys = map[tea.KeyType]int{
+	tea.KeyF13: 1, tea.KeyF14: 2, tea.KeyF15: 3, tea.KeyF16: 4, tea.KeyF17: 5,
+}
+
+// achievementOverlayTicks is how many one-second ticks the achievement
+// overlay stays on screen.
+const achievementOverlayTicks = 4
+
+const (
+	minimapWidth  = 7
+	minimapHeight = 5
 )
 
+// minimapOffsets places each compass direction on the minimap grid relative
+// to the current location, which sits at the center.
+var minimapOffsets = map[string][2]int{
+	"north":     {0, -2},
+	"south":     {0, 2},
+	"east":      {2, 0},
+	"west":      {-2, 0},
+	"northeast": {2, -2},
+	"northwest": {-2, -2},
+	"southeast": {2, 2},
+	"southwest": {-2, 2},
+}
+
+// minimapAliases maps abbreviated directions to their full names.
+var minimapAliases = map[string]string{
+	"n": "north", "s": "south", "e": "east", "w": "west",
+	"ne": "northeast", "nw": "northwest", "se": "southeast", "sw": "southwest",
+}
+
 func NewModel(eng *engine.Engine) model {
 	ta := textarea.New()
 	ta.Placeholder = "Enter a hint or 'random'..."
@@ -119,13 +192,15 @@ func NewModel(eng *engine.Engine) model {
 	s.Spinner = spinner.Dot
 	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
 
-	return model{
+	m := model{
 		state:      stateInputHint,
 		engine:     eng,
 		textArea:   ta,
 		spinner:    s,
 		lastTabIdx: -1,
 	}
+	m.refreshSavesList()
+	return m
 }
 
 func (m model) Init() tea.Cmd {
@@ -140,9 +215,21 @@ type turnProcessedMsg struct {
 	outcome                string
 	status                 string
 	discoveredLocationName string
+	achievements           []models.Achievement
 	err                    error
 }
 
+// statusClearedMsg clears the status message it was scheduled for.
+type statusClearedMsg struct {
+	id int
+}
+
+// achievementOverlayMsg counts down the achievement overlay. The id ties the
+// tick to the overlay that scheduled it, so stale ticks are ignored.
+type achievementOverlayMsg struct {
+	id int
+}
+
 type errMsg struct {
 	err error
 }
@@ -162,6 +249,15 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.lastSearch = ""
 		}
 
+		if m.state == statePlaying && !m.loadingTurn {
+			if slot, ok := quickSaveKeys[msg.Type]; ok {
+				return m, m.quickSave(slot)
+			}
+			if slot, ok := quickLoadKeys[msg.Type]; ok {
+				return m, m.quickLoad(slot)
+			}
+		}
+
 		switch msg.Type {
 		case tea.KeyCtrlC, tea.KeyEsc:
 			return m, tea.Quit
@@ -203,47 +299,7 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 							m.textArea.Reset()
 							return m, nil
 						}
-						m.session = session
-						m.state = statePlaying
-						m.isFinished = false
-						// Reconstruct history
-						m.history = nil
-						m.history = append(m.history, logEntry{
-							IsUser: false,
-							Text:   fmt.Sprintf("%s\nLocation: %s\n\n%s", m.session.World.Title, m.session.State.CurrentLocation, m.session.World.Description),
-						})
-						for _, entry := range m.session.History.Entries {
-							m.history = append(m.history, logEntry{IsUser: true, Text: entry.PlayerAction})
-							m.history = append(m.history, logEntry{IsUser: false, Text: entry.Outcome})
-
-							if len(entry.Explanations) > 0 {
-								for _, exp := range entry.Explanations {
-									m.history = append(m.history, logEntry{
-										IsSideEffect: true,
-										Style:        m.getExplanationStyle(exp, entry.Changes),
-										Text:         exp,
-									})
-								}
-							} else if len(entry.Changes) > 0 {
-								// Fallback for older saves
-								m.history = append(m.history, logEntry{
-									IsSideEffect: true,
-									Text:         m.formatSideEffects(entry.Changes),
-								})
-							}
-							if entry.Status == "WON" || entry.Status == "LOST" {
-								m.isFinished = true
-							}
-						}
-						logWidth := int(float64(m.width) * 0.75)
-						if m.viewport.Width == 0 {
-							m.viewport = viewport.New(logWidth, m.height-8)
-						}
-						m.viewport.SetContent(m.renderLog())
-						m.viewport.GotoBottom()
-						m.textArea.Placeholder = "What do you do?"
-						m.textArea.Reset()
-						m.textArea.SetHeight(3)
+						m.startLoadedSession(session)
 						return m, nil
 					}
 					if hint == "/quit" {
@@ -280,6 +336,9 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						m.history = nil
 						m.session = nil
 						m.isFinished = false
+						m.achievements = nil
+						m.loadedHistoryLen = 0
+						m.refreshSavesList()
 						m.textArea.Placeholder = "Enter a hint or 'random'..."
 						m.textArea.SetHeight(1)
 						return m, nil
@@ -298,10 +357,53 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						return m, nil
 					}
 
+					if len(action) == 4 && strings.HasPrefix(action, "/qs") && action[3] >= '1' && action[3] <= '5' {
+						return m, m.quickSave(int(action[3] - '0'))
+					}
+					if len(action) == 4 && strings.HasPrefix(action, "/ql") && action[3] >= '1' && action[3] <= '5' {
+						return m, m.quickLoad(int(action[3] - '0'))
+					}
+
+					if action == "/history" || action == "/history --full" {
+						m.history = append(m.history, logEntry{IsUser: false, Text: m.formatSummary(action == "/history --full")})
+						m.viewport.SetContent(m.renderLog())
+						m.viewport.GotoBottom()
+						return m, nil
+					}
+
+					if action == "/ambiguity "+models.AmbiguityAsk || action == "/ambiguity "+models.AmbiguityInterpret {
+						m.session.World.AmbiguityHandling = strings.TrimPrefix(action, "/ambiguity ")
+						if err := m.session.Save(m.session.World.ShortName); err != nil {
+							m.history = append(m.history, logEntry{IsUser: false, Text: "Failed to save: " + err.Error()})
+						} else {
+							m.history = append(m.history, logEntry{IsUser: false, Text: "Ambiguous actions will now be handled with: " + m.session.World.AmbiguityHandling})
+						}
+						m.viewport.SetContent(m.renderLog())
+						m.viewport.GotoBottom()
+						return m, nil
+					}
+
+					if strings.HasPrefix(action, "/set-style ") {
+						sample := strings.TrimSpace(strings.TrimPrefix(action, "/set-style "))
+						m.session.World.SampleDialogue = sample
+						if err := m.session.Save(m.session.World.ShortName); err != nil {
+							m.history = append(m.history, logEntry{IsUser: false, Text: "Failed to save: " + err.Error()})
+						} else {
+							m.history = append(m.history, logEntry{IsUser: false, Text: "Writing style updated."})
+						}
+						m.viewport.SetContent(m.renderLog())
+						m.viewport.GotoBottom()
+						return m, nil
+					}
+
 					// Unrecognized command during play
-					errMsg := "Unrecognized command. Valid commands: /save <name>, /restart, /quit"
+					errMsg := "Unrecognized command. Valid commands: /save <name>, /qs1-/qs5, /ql1-/ql5, /history [--full], /set-style <sample-text>, /ambiguity <ask|interpret>, /restart, /quit"
 					if action == "/save" {
 						errMsg = "Usage: /save <name>"
+					} else if action == "/set-style" {
+						errMsg = "Usage: /set-style <sample-text>"
+					} else if strings.HasPrefix(action, "/ambiguity") {
+						errMsg = "Usage: /ambiguity <ask|interpret>"
 					}
 					m.history = append(m.history, logEntry{IsUser: false, Text: errorStyle.Render(errMsg)})
 					m.viewport.SetContent(m.renderLog())
@@ -368,8 +470,9 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			})
 		}
 
-		// Check for side effects in the latest history entry
-		if len(m.session.History.Entries) > 0 {
+		// Check for side effects in the latest history entry. A clarification
+		// request does not add an entry, so there is nothing new to show.
+		if msg.status != engine.StatusClarify && len(m.session.History.Entries) > 0 {
 			last := m.session.History.Entries[len(m.session.History.Entries)-1]
 			if len(last.Explanations) > 0 {
 				for _, exp := range last.Explanations {
@@ -396,6 +499,30 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.isFinished = true
 		}
 
+		if len(msg.achievements) > 0 {
+			m.achievements = append(m.achievements, msg.achievements...)
+			m.achievementTicks = achievementOverlayTicks
+			m.achievementID++
+			return m, achievementTick(m.achievementID)
+		}
+
+		return m, nil
+
+	case statusClearedMsg:
+		if msg.id == m.statusID {
+			m.statusMsg = ""
+		}
+		return m, nil
+
+	case achievementOverlayMsg:
+		if msg.id != m.achievementID {
+			return m, nil
+		}
+		m.achievementTicks--
+		if m.achievementTicks > 0 {
+			return m, achievementTick(m.achievementID)
+		}
+		m.achievements = nil
 		return m, nil
 
 	case errMsg:
@@ -412,22 +539,124 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// refreshSavesList rebuilds the list of saves shown on the hint screen.
+// Finished games are dimmed and quick saves are listed separately.
+func (m *model) refreshSavesList() {
+	saves, _ := models.ListSessions()
+	var named, quick []string
+	for _, save := range saves {
+		label := save
+		if active, err := models.IsSessionActive(save); err == nil && !active {
+			label = helpStyle.Render(save + " (finished)")
+		}
+		if strings.HasPrefix(save, quickSavePrefix) {
+			quick = append(quick, label)
+		} else {
+			named = append(named, label)
+		}
+	}
+
+	m.savesList = ""
+	if len(saves) > 0 {
+		m.savesList = "\nOr load a previous game: /load <name> (Press Tab to auto-complete)\n"
+		if len(named) > 0 {
+			m.savesList += "Available saves: " + strings.Join(named, ", ") + "\n"
+		}
+		if len(quick) > 0 {
+			m.savesList += "\n" + titleStyle.Render("QUICK SAVES") + "\n" + strings.Join(quick, ", ") + "\n"
+		}
+	}
+}
+
+// startLoadedSession switches to playing a saved session, rebuilding the
+// log from its history.
+func (m *model) startLoadedSession(session *models.GameSession) {
+	m.session = session
+	m.state = statePlaying
+	m.isFinished = false
+	m.achievements = nil
+	// Reconstruct history
+	m.history = nil
+	m.history = append(m.history, logEntry{
+		IsUser: false,
+		Text:   fmt.Sprintf("%s\nLocation: %s\n\n%s", m.session.World.Title, m.session.State.CurrentLocation, m.session.World.Description),
+	})
+	for _, entry := range m.session.History.Entries {
+		m.history = append(m.history, logEntry{IsUser: true, Text: entry.PlayerAction})
+		m.history = append(m.history, logEntry{IsUser: false, Text: entry.Outcome})
+
+		if len(entry.Explanations) > 0 {
+			for _, exp := range entry.Explanations {
+				m.history = append(m.history, logEntry{
+					IsSideEffect: true,
+					Style:        m.getExplanationStyle(exp, entry.Changes),
+					Text:         exp,
+				})
+			}
+		} else if len(entry.Changes) > 0 {
+			// Fallback for older saves
+			m.history = append(m.history, logEntry{
+				IsSideEffect: true,
+				Text:         m.formatSideEffects(entry.Changes),
+			})
+		}
+	}
+	m.isFinished = !m.session.IsActive()
+	m.loadedHistoryLen = len(m.history)
+	logWidth := int(float64(m.width) * 0.75)
+	if m.viewport.Width == 0 {
+		m.viewport = viewport.New(logWidth, m.height-8)
+	}
+	m.viewport.SetContent(m.renderLog())
+	m.viewport.GotoBottom()
+	m.textArea.Placeholder = "What do you do?"
+	m.textArea.Reset()
+	m.textArea.SetHeight(3)
+}
+
+// quickSave saves the session to the given quick-save slot and shows a
+// confirmation.
+func (m *model) quickSave(slot int) tea.Cmd {
+	if err := m.session.Save(fmt.Sprintf("%s%d", quickSavePrefix, slot)); err != nil {
+		return m.setStatus(errorStyle.Render("Failed to quick-save: " + err.Error()))
+	}
+	return m.setStatus(successStyle.Render(fmt.Sprintf("Quick-saved to slot %d", slot)))
+}
+
+// quickLoad replaces the current session with the one in the given
+// quick-save slot.
+func (m *model) quickLoad(slot int) tea.Cmd {
+	session, err := models.LoadSession(fmt.Sprintf("%s%d", quickSavePrefix, slot))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return m.setStatus(errorStyle.Render(fmt.Sprintf("Quick-save slot %d is empty", slot)))
+		}
+		return m.setStatus(errorStyle.Render(fmt.Sprintf("Failed to quick-load slot %d: %v", slot, err)))
+	}
+	m.startLoadedSession(session)
+	return m.setStatus(successStyle.Render(fmt.Sprintf("Quick-loaded slot %d", slot)))
+}
+
+// setStatus shows the styled msg above the help line and clears it after two seconds.
+func (m *model) setStatus(msg string) tea.Cmd {
+	m.statusMsg = msg
+	m.statusID++
+	id := m.statusID
+	return tea.Tick(2*time.Second, func(time.Time) tea.Msg {
+		return statusClearedMsg{id: id}
+	})
+}
+
 func (m model) View() string {
 	var s string
 	wrapStyle := lipgloss.NewStyle().Width(m.width)
 
 	switch m.state {
 	case stateInputHint:
-		saves, _ := models.ListSessions()
-		savesList := ""
-		if len(saves) > 0 {
-			savesList = "\nOr load a previous game: /load <name> (Press Tab to auto-complete)\nAvailable saves: " + strings.Join(saves, ", ") + "\n"
-		}
-
 		welcomeText := fmt.Sprintf(
 			"Welcome to the Text Game Generator!\n\n%s\n%s",
 			"Give me a hint about the world you want to play in (e.g., 'cyberpunk detective', 'zombie kitchen'):",
-			savesList,
+			m.savesList,
 		)
 
 		s = wrapStyle.Render(welcomeText)
@@ -441,6 +670,15 @@ func (m model) View() string {
 
 	case statePlaying:
 		logView := m.viewport.View()
+		if len(m.achievements) > 0 {
+			// Show the achievements above the log, shrinking it so the
+			// latest outcome stays visible underneath
+			panel := lipgloss.PlaceHorizontal(m.viewport.Width, lipgloss.Center, m.renderAchievements())
+			vp := m.viewport
+			vp.Height = max(vp.Height-lipgloss.Height(panel), 0)
+			vp.GotoBottom()
+			logView = lipgloss.JoinVertical(lipgloss.Left, panel, vp.View())
+		}
 		stateView := m.renderState()
 
 		// Join log and state horizontally
@@ -449,7 +687,7 @@ func (m model) View() string {
 			stateView,
 		)
 
-		help := helpStyle.Render("Commands: /save <name>, /restart, /quit, or just type what you want to do.")
+		help := helpStyle.Render("Commands: /save <name>, F1-F5 (/qs1-/qs5) quick-save, Shift+F1-F5 (/ql1-/ql5) quick-load, /history [--full], /set-style <sample-text>, /ambiguity <ask|interpret>, /restart, /quit, or just type what you want to do.")
 
 		var inputArea string
 		if m.loadingTurn {
@@ -460,6 +698,10 @@ func (m model) View() string {
 			inputArea = "\n" + m.textArea.View()
 		}
 
+		if m.statusMsg != "" {
+			help = m.statusMsg + "\n" + help
+		}
+
 		s = lipgloss.JoinVertical(lipgloss.Left,
 			mainView,
 			inputArea,
@@ -506,6 +748,19 @@ func (m model) renderState() string {
 			}
 			locInfo += "\n"
 		}
+		if len(loc.Exits) > 0 {
+			locInfo += titleStyle.Render("EXITS") + "\n"
+			var dirs []string
+			for d := range loc.Exits {
+				dirs = append(dirs, d)
+			}
+			sort.Strings(dirs)
+			for _, d := range dirs {
+				locInfo += "- " + wrapState.Render(fmt.Sprintf("%s: %s", d, loc.Exits[d])) + "\n"
+			}
+			locInfo += "\n"
+		}
+		locInfo += m.renderMinimap(loc) + "\n\n"
 	}
 
 	// Stats
@@ -555,11 +810,102 @@ func (m model) renderState() string {
 	return stateStyle.Width(stateWidth).Height(m.viewport.Height).Render(content)
 }
 
+// renderMinimap draws a small grid of the location graph around loc. The
+// current location is "@", connected locations are "o", exits leading
+// somewhere not yet discovered are "?", and other known locations are dots.
+func (m model) renderMinimap(loc models.Location) string {
+	grid := make([][]string, minimapHeight)
+	for y := range grid {
+		grid[y] = make([]string, minimapWidth)
+		for x := range grid[y] {
+			grid[y][x] = " "
+		}
+	}
+
+	cx, cy := minimapWidth/2, minimapHeight/2
+	grid[cy][cx] = boldStyle.Render("@")
+
+	connected := map[string]bool{loc.Name: true}
+	for dir, target := range loc.Exits {
+		dir = strings.ToLower(dir)
+		if full, ok := minimapAliases[dir]; ok {
+			dir = full
+		}
+		off, ok := minimapOffsets[dir]
+		if !ok {
+			continue
+		}
+		connected[target] = true
+
+		// Draw the connecting edge halfway between the two nodes
+		var edge string
+		switch {
+		case off[0] == 0:
+			edge = "|"
+		case off[1] == 0:
+			edge = "-"
+		case off[0] == off[1]:
+			edge = "\\"
+		default:
+			edge = "/"
+		}
+		grid[cy+off[1]/2][cx+off[0]/2] = faintStyle.Render(edge)
+
+		node := "?"
+		if _, known := m.session.Locations[target]; known {
+			node = "o"
+		}
+		grid[cy+off[1]][cx+off[0]] = node
+	}
+
+	// Fill the outer columns with dots for known but unconnected locations
+	var others []string
+	for name := range m.session.Locations {
+		if !connected[name] {
+			others = append(others, name)
+		}
+	}
+	sort.Strings(others)
+	var slots [][2]int
+	for y := 0; y < minimapHeight; y++ {
+		slots = append(slots, [2]int{0, y}, [2]int{minimapWidth - 1, y})
+	}
+	for i := range others {
+		if i >= len(slots) {
+			break
+		}
+		grid[slots[i][1]][slots[i][0]] = faintStyle.Render("·")
+	}
+
+	rows := make([]string, minimapHeight)
+	for y := range grid {
+		rows[y] = strings.Join(grid[y], "")
+	}
+	return titleStyle.Render("MAP") + "\n" + strings.Join(rows, "\n")
+}
+
+// renderAchievements stacks a gold-bordered panel for each newly unlocked
+// achievement.
+func (m model) renderAchievements() string {
+	panels := []string{titleStyle.Render("ACHIEVEMENT UNLOCKED")}
+	for _, a := range m.achievements {
+		panels = append(panels, achievementStyle.Render(
+			achievementNameStyle.Render(a.Name)+"\n"+a.Description,
+		))
+	}
+	return lipgloss.JoinVertical(lipgloss.Center, panels...)
+}
+
 func (m model) renderLog() string {
 	var b strings.Builder
 	logWidth := int(float64(m.width) * 0.75)
 
 	for i, entry := range m.history {
+		if i > 0 && i == m.loadedHistoryLen {
+			b.WriteString(helpStyle.Render("─── Loaded ───"))
+			b.WriteString("\n\n")
+		}
+
 		var styled string
 		if entry.Style != nil {
 			styled = entry.Style.Width(logWidth).Render(entry.Text)
@@ -586,6 +932,46 @@ func (m model) renderLog() string {
 	return b.String()
 }
 
+// formatSummary describes the summarized history. With full set, every
+// revision of the summary is listed so drift can be spotted.
+func (m model) formatSummary(full bool) string {
+	h := m.session.History
+	if h.Summary == "" {
+		return "No summary yet."
+	}
+
+	// Saves from before the summary log only have the latest summary
+	versions := h.SummaryLog
+	if len(versions) == 0 {
+		versions = []string{h.Summary}
+	}
+
+	// The first summary is the original; every later one is a revision
+	header := "Summary"
+	switch revisions := len(versions) - 1; revisions {
+	case 0:
+	case 1:
+		header += " (revised once)"
+	default:
+		header += fmt.Sprintf(" (revised %d times)", revisions)
+	}
+
+	if !full {
+		return fmt.Sprintf("%s: %s", header, h.Summary)
+	}
+
+	var b strings.Builder
+	b.WriteString(header + ":")
+	for i, summary := range versions {
+		label := fmt.Sprintf("Revision %d", i)
+		if i == 0 {
+			label = "Original"
+		}
+		fmt.Fprintf(&b, "\n\n%s: %s", label, summary)
+	}
+	return b.String()
+}
+
 func (m model) formatSideEffects(changes map[string]string) string {
 	var results []string
 	for k, v := range changes {
@@ -724,10 +1110,20 @@ func (m model) generateWorld(hint string) tea.Cmd {
 func (m model) processTurn(action string) tea.Cmd {
 	return func() tea.Msg {
 		outcome, status, discovered, err := m.engine.ProcessTurn(context.Background(), m.session, action)
-		return turnProcessedMsg{outcome, status, discovered, err}
+		var unlocked []models.Achievement
+		if err == nil && status != engine.StatusClarify && len(m.session.History.Entries) > 0 {
+			unlocked = m.session.History.Entries[len(m.session.History.Entries)-1].Achievements
+		}
+		return turnProcessedMsg{outcome, status, discovered, unlocked, err}
 	}
 }
 
+func achievementTick(id int) tea.Cmd {
+	return tea.Tick(time.Second, func(time.Time) tea.Msg {
+		return achievementOverlayMsg{id: id}
+	})
+}
+
 func Start() error {
 	ctx := context.Background()
 
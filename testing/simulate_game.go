@@ -2,8 +2,10 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"os"
 	"strings"
 
 	"github.com/google/generative-ai-go/genai"
@@ -13,7 +15,27 @@ import (
 	"google.golang.org/api/option"
 )
 
-const maxTurns = 10
+const (
+	maxTurns   = 10
+	reportFile = "simulation-report.json"
+)
+
+// simulationReport summarizes the game master's API usage in a simulated
+// game: world generation plus every turn, including one that failed. The
+// player LLM's own usage is not counted.
+type simulationReport struct {
+	Theme                 string  `json:"theme"`
+	Turns                 int     `json:"turns"`
+	WorldTokens           int     `json:"world_tokens"`
+	TurnTokens            []int   `json:"turn_tokens"`
+	TurnError             string  `json:"turn_error,omitempty"`
+	TotalTokens           int     `json:"total_tokens"`
+	AverageTokensPerTurn  float64 `json:"average_tokens_per_turn"`
+	MinTokensPerTurn      int     `json:"min_tokens_per_turn"`
+	MaxTokensPerTurn      int     `json:"max_tokens_per_turn"`
+	PricePerMillionTokens float64 `json:"price_per_million_tokens"`
+	EstimatedCost         float64 `json:"estimated_cost"`
+}
 
 func main() {
 	ctx := context.Background()
@@ -53,8 +75,14 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to generate world: %v", err)
 	}
+	report := simulationReport{
+		Theme:                 theme,
+		WorldTokens:           gmEngine.LastCallTokens(),
+		PricePerMillionTokens: cfg.PricePerMillionTokens,
+	}
 	fmt.Printf("Title: %s\n", session.World.Title)
-	fmt.Printf("Initial Description: %s\n\n", session.World.Description)
+	fmt.Printf("Initial Description: %s\n", session.World.Description)
+	fmt.Printf("Tokens: %d\n\n", report.WorldTokens)
 
 	// 3. Play the game
 	for turn := 1; turn <= maxTurns; turn++ {
@@ -66,12 +94,17 @@ func main() {
 
 		// Process Turn
 		outcome, status, discovered, err := gmEngine.ProcessTurn(ctx, session, action)
+		tokens := gmEngine.LastCallTokens()
+		report.TurnTokens = append(report.TurnTokens, tokens)
 		if err != nil {
 			fmt.Printf("Error processing turn: %v\n", err)
+			fmt.Printf("Tokens: %d\n", tokens)
+			report.TurnError = err.Error()
 			break
 		}
 		fmt.Printf("GM Outcome: %s\n", outcome)
 		fmt.Printf("Status: %s\n", status)
+		fmt.Printf("Tokens: %d\n", tokens)
 		if discovered != "" {
 			fmt.Printf("DISCOVERED: %s\n", discovered)
 		}
@@ -95,6 +128,45 @@ func main() {
 			break
 		}
 	}
+
+	// 4. Report API usage
+	report.finalize()
+	fmt.Println("--- Usage Report (Game Master) ---")
+	fmt.Printf("Turns: %d\n", report.Turns)
+	fmt.Printf("World Generation Tokens: %d\n", report.WorldTokens)
+	fmt.Printf("Total Tokens: %d\n", report.TotalTokens)
+	fmt.Printf("Average Tokens/Turn: %.1f\n", report.AverageTokensPerTurn)
+	fmt.Printf("Min/Max Tokens/Turn: %d/%d\n", report.MinTokensPerTurn, report.MaxTokensPerTurn)
+	fmt.Printf("Estimated Cost: $%.4f (at $%.2f per million tokens)\n", report.EstimatedCost, report.PricePerMillionTokens)
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to encode report: %v", err)
+	}
+	if err := os.WriteFile(reportFile, data, 0644); err != nil {
+		log.Fatalf("Failed to write report: %v", err)
+	}
+	fmt.Printf("Report written to %s\n", reportFile)
+}
+
+// finalize computes the aggregate statistics from the token counts. The
+// total and cost include world generation; the per-turn figures do not.
+func (r *simulationReport) finalize() {
+	r.Turns = len(r.TurnTokens)
+	r.TotalTokens = r.WorldTokens
+	if r.Turns > 0 {
+		r.MinTokensPerTurn = r.TurnTokens[0]
+		r.MaxTokensPerTurn = r.TurnTokens[0]
+		turnTotal := 0
+		for _, t := range r.TurnTokens {
+			turnTotal += t
+			r.MinTokensPerTurn = min(r.MinTokensPerTurn, t)
+			r.MaxTokensPerTurn = max(r.MaxTokensPerTurn, t)
+		}
+		r.TotalTokens += turnTotal
+		r.AverageTokensPerTurn = float64(turnTotal) / float64(r.Turns)
+	}
+	r.EstimatedCost = float64(r.TotalTokens) / 1_000_000 * r.PricePerMillionTokens
 }
 
 func getPlayerAction(ctx context.Context, model *genai.GenerativeModel, session *models.GameSession) string {